@@ -0,0 +1,112 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"testing"
+	"time"
+
+	appsv1beta1 "k8s.io/api/apps/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// newFakeAppsV1beta1 builds a FakeAppsV1beta1 backed by a real ObjectTracker,
+// the same wiring NewSimpleClientset does for a full generated clientset.
+func newFakeAppsV1beta1(objects ...runtime.Object) *FakeAppsV1beta1 {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(appsv1beta1.AddToScheme(scheme))
+	codecs := serializer.NewCodecFactory(scheme)
+
+	tracker := clienttesting.NewObjectTracker(scheme, codecs.UniversalDecoder())
+	for _, obj := range objects {
+		if err := tracker.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	fake := &clienttesting.Fake{}
+	fake.AddReactor("*", "*", clienttesting.ObjectReaction(tracker))
+	fake.AddWatchReactor("*", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		w, err := tracker.Watch(action.GetResource(), action.GetNamespace())
+		return true, w, err
+	})
+
+	return &FakeAppsV1beta1{fake}
+}
+
+func TestFakeControllerRevisionsListIsNamespaceFiltered(t *testing.T) {
+	revA := &appsv1beta1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{Name: "rev-a", Namespace: "ns-a"}}
+	revB := &appsv1beta1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{Name: "rev-b", Namespace: "ns-b"}}
+	c := newFakeAppsV1beta1(revA, revB)
+
+	list, err := c.ControllerRevisions("ns-a").List(metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "rev-a" {
+		t.Fatalf("expected only rev-a from ns-a, got %v", list.Items)
+	}
+}
+
+func TestFakeControllerRevisionsUpdateBumpsResourceVersion(t *testing.T) {
+	rev := &appsv1beta1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{Name: "rev", Namespace: "ns", ResourceVersion: "1"}}
+	c := newFakeAppsV1beta1(rev)
+
+	got, err := c.ControllerRevisions("ns").Get("rev", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	got.Revision = 2
+	updated, err := c.ControllerRevisions("ns").Update(got)
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if updated.ResourceVersion == rev.ResourceVersion {
+		t.Fatalf("expected ResourceVersion to change on update, still %q", updated.ResourceVersion)
+	}
+}
+
+func TestFakeControllerRevisionsWatchSeesCreate(t *testing.T) {
+	c := newFakeAppsV1beta1()
+	w, err := c.ControllerRevisions("ns").Watch(metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	defer w.Stop()
+
+	rev := &appsv1beta1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{Name: "rev", Namespace: "ns"}}
+	if _, err := c.ControllerRevisions("ns").Create(rev); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	select {
+	case event := <-w.ResultChan():
+		if event.Type != watch.Added {
+			t.Fatalf("expected Added event, got %v", event.Type)
+		}
+		if got := event.Object.(*appsv1beta1.ControllerRevision); got.Name != "rev" {
+			t.Fatalf("expected watch event for rev, got %q", got.Name)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for watch event from Create")
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apps
+
+import (
+	v1beta1 "k8s.io/kubernetes/pkg/client/informers/generated/externalversions/apps/v1beta1"
+	"k8s.io/kubernetes/pkg/client/informers/generated/externalversions/internalinterfaces"
+)
+
+// Interface provides access to each version of the apps group's informers.
+type Interface interface {
+	// V1beta1 returns a v1beta1.Interface.
+	V1beta1() v1beta1.Interface
+}
+
+type group struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &group{factory: f, tweakListOptions: tweakListOptions}
+}
+
+func (g *group) V1beta1() v1beta1.Interface {
+	return v1beta1.New(g.factory, g.tweakListOptions)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"time"
+
+	appsv1beta1 "k8s.io/api/apps/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	clientset "k8s.io/kubernetes/pkg/client/clientset/generated/typed/apps/v1beta1"
+	"k8s.io/kubernetes/pkg/client/informers/generated/externalversions/internalinterfaces"
+	listers "k8s.io/kubernetes/pkg/client/listers/apps/v1beta1"
+)
+
+// ControllerRevisionInformer provides access to a shared informer and lister for
+// ControllerRevisions.
+type ControllerRevisionInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() listers.ControllerRevisionLister
+}
+
+type controllerRevisionInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewControllerRevisionInformer constructs a new informer for ControllerRevisions
+// that reuses the typed client's List/Watch directly, without the indirection
+// of a custom NewInformerFunc parameter or a TweakListOptionsFunc.
+func NewControllerRevisionInformer(client clientset.AppsV1beta1Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredControllerRevisionInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredControllerRevisionInformer constructs a new informer for
+// ControllerRevisions, allowing a TweakListOptionsFunc to customize the
+// ListOptions used for its initial List and subsequent Watch. In addition to
+// whatever indexers the caller passes in, it always registers the
+// byOwnerUID index so callers can enumerate revisions by owner without a
+// bespoke cache.
+func NewFilteredControllerRevisionInformer(client clientset.AppsV1beta1Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	// Copy rather than mutate the caller's indexers map: callers may reuse it
+	// across informers, and one already keyed under byOwnerUID would
+	// otherwise be silently clobbered.
+	withOwnerUIDIndex := cache.Indexers{}
+	for key, indexFunc := range indexers {
+		withOwnerUIDIndex[key] = indexFunc
+	}
+	withOwnerUIDIndex[listers.ControllerRevisionOwnerUIDIndex] = listers.ControllerRevisionOwnerUIDIndexFunc
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ControllerRevisions(namespace).List(options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ControllerRevisions(namespace).Watch(options)
+			},
+		},
+		&appsv1beta1.ControllerRevision{},
+		resyncPeriod,
+		withOwnerUIDIndex,
+	)
+}
+
+func (f *controllerRevisionInformer) defaultInformer(client clientset.AppsV1beta1Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredControllerRevisionInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *controllerRevisionInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&appsv1beta1.ControllerRevision{}, func(client clientset.AppsV1beta1Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+		return f.defaultInformer(client, resyncPeriod)
+	})
+}
+
+func (f *controllerRevisionInformer) Lister() listers.ControllerRevisionLister {
+	return listers.NewControllerRevisionLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalversions
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	v1beta1client "k8s.io/kubernetes/pkg/client/clientset/generated/typed/apps/v1beta1"
+	"k8s.io/kubernetes/pkg/client/informers/generated/externalversions/apps"
+	"k8s.io/kubernetes/pkg/client/informers/generated/externalversions/internalinterfaces"
+)
+
+// SharedInformerFactory provides shared informers for every resource this
+// chunk generates informers for, so callers get a ControllerRevisionInformer
+// without hand-rolling the factory struct themselves.
+type SharedInformerFactory interface {
+	internalinterfaces.SharedInformerFactory
+	Apps() apps.Interface
+}
+
+type sharedInformerFactory struct {
+	client           v1beta1client.AppsV1beta1Interface
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	defaultResync    time.Duration
+
+	lock      sync.Mutex
+	informers map[reflect.Type]cache.SharedIndexInformer
+	// startedInformers lets Start be called more than once without
+	// re-launching an informer that's already running.
+	startedInformers map[reflect.Type]bool
+}
+
+// NewSharedInformerFactory constructs a SharedInformerFactory whose informers
+// resync every defaultResync.
+func NewSharedInformerFactory(client v1beta1client.AppsV1beta1Interface, defaultResync time.Duration) SharedInformerFactory {
+	return NewFilteredSharedInformerFactory(client, defaultResync, nil)
+}
+
+// NewFilteredSharedInformerFactory is like NewSharedInformerFactory, but lets
+// the caller adjust ListOptions (e.g. a field or label selector) before every
+// informer's initial List and subsequent Watch.
+func NewFilteredSharedInformerFactory(client v1beta1client.AppsV1beta1Interface, defaultResync time.Duration, tweakListOptions internalinterfaces.TweakListOptionsFunc) SharedInformerFactory {
+	return &sharedInformerFactory{
+		client:           client,
+		tweakListOptions: tweakListOptions,
+		defaultResync:    defaultResync,
+		informers:        map[reflect.Type]cache.SharedIndexInformer{},
+		startedInformers: map[reflect.Type]bool{},
+	}
+}
+
+// Start begins every informer this factory has created so far that hasn't
+// already been started. Safe to call more than once.
+func (f *sharedInformerFactory) Start(stopCh <-chan struct{}) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for informerType, informer := range f.informers {
+		if !f.startedInformers[informerType] {
+			go informer.Run(stopCh)
+			f.startedInformers[informerType] = true
+		}
+	}
+}
+
+// InformerFor returns the shared informer for obj's type, constructing it
+// with newFunc the first time it's requested so repeated calls (e.g. from
+// both a lister and a controller) share one watch against the API server.
+func (f *sharedInformerFactory) InformerFor(obj interface{}, newFunc internalinterfaces.NewInformerFunc) cache.SharedIndexInformer {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	informerType := reflect.TypeOf(obj)
+	if informer, exists := f.informers[informerType]; exists {
+		return informer
+	}
+
+	informer := newFunc(f.client, f.defaultResync)
+	f.informers[informerType] = informer
+	return informer
+}
+
+// WaitForCacheSync blocks until every informer this factory has started has
+// synced its cache, or stopCh is closed.
+func (f *sharedInformerFactory) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
+	informers := func() map[reflect.Type]cache.SharedIndexInformer {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+
+		started := map[reflect.Type]cache.SharedIndexInformer{}
+		for informerType, informer := range f.informers {
+			if f.startedInformers[informerType] {
+				started[informerType] = informer
+			}
+		}
+		return started
+	}()
+
+	res := map[reflect.Type]bool{}
+	for informerType, informer := range informers {
+		res[informerType] = cache.WaitForCacheSync(stopCh, informer.HasSynced)
+	}
+	return res
+}
+
+// Apps returns the Interface for the apps group.
+func (f *sharedInformerFactory) Apps() apps.Interface {
+	return apps.New(f, f.tweakListOptions)
+}
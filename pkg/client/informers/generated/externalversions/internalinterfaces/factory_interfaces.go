@@ -0,0 +1,48 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internalinterfaces
+
+import (
+	"reflect"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	v1beta1client "k8s.io/kubernetes/pkg/client/clientset/generated/typed/apps/v1beta1"
+)
+
+// NewInformerFunc takes a typed client and a resync period and returns a
+// SharedIndexInformer for the corresponding resource.
+type NewInformerFunc func(v1beta1client.AppsV1beta1Interface, time.Duration) cache.SharedIndexInformer
+
+// SharedInformerFactory is the interface generated informers need to look up
+// an informer for their type and obtain the shared client used to build it.
+// It is deliberately small: this chunk only wires up the apps/v1beta1
+// ControllerRevision informer, so it does not carry the full generated
+// clientset or every group/version accessor yet.
+type SharedInformerFactory interface {
+	Start(stopCh <-chan struct{})
+	InformerFor(obj interface{}, newFunc NewInformerFunc) cache.SharedIndexInformer
+	// WaitForCacheSync blocks until every informer this factory has started
+	// has synced its cache, or stopCh is closed, returning the per-informer
+	// sync result.
+	WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool
+}
+
+// TweakListOptionsFunc is for callers that want to adjust ListOptions (e.g.
+// applying a field or label selector) before an informer's initial List/Watch.
+type TweakListOptionsFunc func(*metav1.ListOptions)
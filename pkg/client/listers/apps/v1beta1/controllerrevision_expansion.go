@@ -0,0 +1,63 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	v1beta1 "k8s.io/api/apps/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ControllerRevisionOwnerUIDIndex is the name of the indexer, registered on the
+// ControllerRevisionInformer's indexer, that keys ControllerRevisions by the
+// UIDs of their owner references. Controllers that manage revisions (rollout
+// managers doing revision-based rollback, for example) use it to enumerate the
+// revisions belonging to a given owner without a full list-and-filter.
+const ControllerRevisionOwnerUIDIndex = "byOwnerUID"
+
+// ControllerRevisionOwnerUIDIndexFunc indexes ControllerRevisions by the UIDs
+// of their owner references.
+func ControllerRevisionOwnerUIDIndexFunc(obj interface{}) ([]string, error) {
+	cr, ok := obj.(*v1beta1.ControllerRevision)
+	if !ok {
+		return nil, fmt.Errorf("object is not a ControllerRevision: %T", obj)
+	}
+	owners := make([]string, 0, len(cr.OwnerReferences))
+	for _, ref := range cr.OwnerReferences {
+		owners = append(owners, string(ref.UID))
+	}
+	return owners, nil
+}
+
+// GetControllerRevisions returns the ControllerRevisions in this namespace
+// owned by the object with the given UID.
+func (s controllerRevisionNamespaceLister) GetControllerRevisions(ownerUID types.UID) ([]*v1beta1.ControllerRevision, error) {
+	objs, err := s.indexer.ByIndex(ControllerRevisionOwnerUIDIndex, string(ownerUID))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*v1beta1.ControllerRevision, 0, len(objs))
+	for _, obj := range objs {
+		cr := obj.(*v1beta1.ControllerRevision)
+		if cr.Namespace != s.namespace {
+			continue
+		}
+		ret = append(ret, cr)
+	}
+	return ret, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	v1beta1 "k8s.io/api/apps/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestControllerRevisionOwnerUIDIndexFunc(t *testing.T) {
+	rev := &v1beta1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "rev",
+			OwnerReferences: []metav1.OwnerReference{
+				{UID: types.UID("owner-1")},
+				{UID: types.UID("owner-2")},
+			},
+		},
+	}
+
+	uids, err := ControllerRevisionOwnerUIDIndexFunc(rev)
+	if err != nil {
+		t.Fatalf("ControllerRevisionOwnerUIDIndexFunc: %v", err)
+	}
+	if len(uids) != 2 || uids[0] != "owner-1" || uids[1] != "owner-2" {
+		t.Fatalf("expected [owner-1 owner-2], got %v", uids)
+	}
+
+	if _, err := ControllerRevisionOwnerUIDIndexFunc("not a ControllerRevision"); err == nil {
+		t.Fatal("expected an error indexing a non-ControllerRevision object")
+	}
+}
+
+func TestGetControllerRevisionsFiltersByOwnerAndNamespace(t *testing.T) {
+	owner := types.UID("owner-1")
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{ControllerRevisionOwnerUIDIndex: ControllerRevisionOwnerUIDIndexFunc})
+
+	owned := &v1beta1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "owned",
+			Namespace:       "ns-a",
+			OwnerReferences: []metav1.OwnerReference{{UID: owner}},
+		},
+	}
+	ownedOtherNamespace := &v1beta1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "owned-elsewhere",
+			Namespace:       "ns-b",
+			OwnerReferences: []metav1.OwnerReference{{UID: owner}},
+		},
+	}
+	unowned := &v1beta1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "unowned",
+			Namespace: "ns-a",
+		},
+	}
+	indexer.Add(owned)
+	indexer.Add(ownedOtherNamespace)
+	indexer.Add(unowned)
+
+	lister := NewControllerRevisionLister(indexer)
+	revs, err := lister.ControllerRevisions("ns-a").GetControllerRevisions(owner)
+	if err != nil {
+		t.Fatalf("GetControllerRevisions: %v", err)
+	}
+	if len(revs) != 1 || revs[0].Name != "owned" {
+		t.Fatalf("expected only the ns-a revision owned by %q, got %v", owner, revs)
+	}
+}
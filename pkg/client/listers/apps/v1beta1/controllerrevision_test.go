@@ -0,0 +1,67 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	v1beta1 "k8s.io/api/apps/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestControllerRevisionListerListsAcrossNamespaces(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	indexer.Add(&v1beta1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{Name: "rev-a", Namespace: "ns-a"}})
+	indexer.Add(&v1beta1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{Name: "rev-b", Namespace: "ns-b"}})
+
+	lister := NewControllerRevisionLister(indexer)
+	revs, err := lister.List(labels.Everything())
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(revs) != 2 {
+		t.Fatalf("expected 2 ControllerRevisions across all namespaces, got %d", len(revs))
+	}
+}
+
+func TestControllerRevisionNamespaceListerScopesToNamespace(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	indexer.Add(&v1beta1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{Name: "rev-a", Namespace: "ns-a"}})
+	indexer.Add(&v1beta1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{Name: "rev-b", Namespace: "ns-b"}})
+
+	lister := NewControllerRevisionLister(indexer)
+	revs, err := lister.ControllerRevisions("ns-a").List(labels.Everything())
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(revs) != 1 || revs[0].Name != "rev-a" {
+		t.Fatalf("expected only rev-a in ns-a, got %v", revs)
+	}
+
+	if _, err := lister.ControllerRevisions("ns-a").Get("rev-b"); err == nil {
+		t.Fatalf("expected Get of rev-b in ns-a to fail, rev-b lives in ns-b")
+	}
+	got, err := lister.ControllerRevisions("ns-a").Get("rev-a")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Name != "rev-a" {
+		t.Fatalf("expected rev-a, got %q", got.Name)
+	}
+}
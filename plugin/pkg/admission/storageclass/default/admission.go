@@ -0,0 +1,302 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admission implements an admission plugin that stamps the cluster's
+// default StorageClass onto PersistentVolumeClaims that don't request one.
+package admission
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/golang/glog"
+
+	clientv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/client/cache"
+	clientset "k8s.io/kubernetes/pkg/client/clientset_generated/internalclientset"
+	informers "k8s.io/kubernetes/pkg/client/informers/informers_generated/internalversion"
+)
+
+const (
+	isDefaultAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+	classAnnotation     = "volume.beta.kubernetes.io/storage-class"
+	// namespaceDefaultClassAnnotation lets a namespace steer PVCs created
+	// without an explicit class to a StorageClass other than the cluster
+	// default, e.g. to point a tenant at its own provisioner.
+	namespaceDefaultClassAnnotation = "storageclass.kubernetes.io/default-class"
+)
+
+// MultipleDefaultsPolicy controls what the plugin does when it finds more
+// than one StorageClass flagged as the cluster default.
+type MultipleDefaultsPolicy string
+
+const (
+	// Reject fails admission, naming the conflicting classes. This was the
+	// plugin's only behavior before MultipleDefaultsPolicy existed, and
+	// remains the default.
+	Reject MultipleDefaultsPolicy = "Reject"
+	// PickNewest picks the default StorageClass with the newest
+	// CreationTimestamp, breaking ties on name.
+	PickNewest MultipleDefaultsPolicy = "PickNewest"
+	// PickOldest picks the default StorageClass with the oldest
+	// CreationTimestamp, breaking ties on name.
+	PickOldest MultipleDefaultsPolicy = "PickOldest"
+)
+
+func init() {
+	admission.RegisterPlugin("DefaultStorageClass", func(config io.Reader) (admission.Interface, error) {
+		pluginConfig, err := loadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+		return newPlugin(pluginConfig), nil
+	})
+}
+
+// Configuration is the admission config file schema for this plugin, decoded
+// from the file named by --admission-control-config-file.
+type Configuration struct {
+	// MultipleDefaultsPolicy decides how to resolve more than one
+	// StorageClass being marked as the cluster default. Defaults to Reject.
+	MultipleDefaultsPolicy MultipleDefaultsPolicy
+}
+
+func loadConfiguration(config io.Reader) (*Configuration, error) {
+	pluginConfig := &Configuration{MultipleDefaultsPolicy: Reject}
+	if config == nil {
+		return pluginConfig, nil
+	}
+	d := yaml.NewYAMLOrJSONDecoder(config, 4096)
+	if err := d.Decode(pluginConfig); err != nil {
+		return nil, err
+	}
+	if pluginConfig.MultipleDefaultsPolicy == "" {
+		pluginConfig.MultipleDefaultsPolicy = Reject
+	}
+	return pluginConfig, nil
+}
+
+// claimDefaulterPlugin stamps the cluster default StorageClass onto
+// PersistentVolumeClaims created without one.
+type claimDefaulterPlugin struct {
+	*admission.Handler
+	// store is the StorageClass informer's store, wired up by
+	// SetInternalKubeInformerFactory. Unit tests that construct the plugin
+	// directly via newPlugin populate it by hand instead.
+	store cache.Store
+	// namespaceStore is the Namespace informer's store, also wired up by
+	// SetInternalKubeInformerFactory; it's how the plugin finds the
+	// per-namespace default class override without hitting the API server on
+	// every Admit call.
+	namespaceStore cache.Store
+	policy         MultipleDefaultsPolicy
+	// eventRecorder surfaces conflicting-default and override-fallback
+	// warnings as Events on the PVC being admitted, so a namespace admin can
+	// see them via `kubectl get events` instead of only the apiserver log.
+	// It is nil until SetInternalKubeClientSet runs (e.g. in unit tests that
+	// construct the plugin directly), so every use of it is guarded.
+	eventRecorder record.EventRecorder
+}
+
+var _ admission.Interface = &claimDefaulterPlugin{}
+var _ admission.WantsInternalKubeClientSet = &claimDefaulterPlugin{}
+var _ admission.WantsInternalKubeInformerFactory = &claimDefaulterPlugin{}
+
+func newPlugin(config *Configuration) *claimDefaulterPlugin {
+	policy := Reject
+	if config != nil && config.MultipleDefaultsPolicy != "" {
+		policy = config.MultipleDefaultsPolicy
+	}
+	return &claimDefaulterPlugin{
+		Handler:        admission.NewHandler(admission.Create),
+		store:          cache.NewStore(cache.MetaNamespaceKeyFunc),
+		namespaceStore: cache.NewStore(cache.MetaNamespaceKeyFunc),
+		policy:         policy,
+	}
+}
+
+// SetInternalKubeClientSet implements admission.WantsInternalKubeClientSet,
+// giving the plugin an event sink backed by the real API server.
+func (c *claimDefaulterPlugin) SetInternalKubeClientSet(client clientset.Interface) {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&record.EventSinkImpl{Interface: client.Core().Events("")})
+	c.eventRecorder = broadcaster.NewRecorder(api.Scheme, clientv1.EventSource{Component: "DefaultStorageClass"})
+}
+
+// eventf records an Event against obj if an eventRecorder has been wired up,
+// and is always safe to call (e.g. from the newPlugin(nil) path tests use).
+func (c *claimDefaulterPlugin) eventf(obj runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	if c.eventRecorder == nil {
+		return
+	}
+	c.eventRecorder.Eventf(obj, eventtype, reason, messageFmt, args...)
+}
+
+// SetInternalKubeInformerFactory implements admission.WantsInternalKubeInformerFactory,
+// replacing the empty stores from newPlugin with the real StorageClass
+// and Namespace informers' stores, and gating Admit on both having completed
+// their initial List before serving requests.
+func (c *claimDefaulterPlugin) SetInternalKubeInformerFactory(f informers.SharedInformerFactory) {
+	storageClassInformer := f.Storage().InternalVersion().StorageClasses()
+	c.store = storageClassInformer.Informer().GetStore()
+
+	namespaceInformer := f.Core().InternalVersion().Namespaces()
+	c.namespaceStore = namespaceInformer.Informer().GetStore()
+
+	c.SetReadyFunc(func() bool {
+		return storageClassInformer.Informer().HasSynced() && namespaceInformer.Informer().HasSynced()
+	})
+}
+
+func (c *claimDefaulterPlugin) Admit(a admission.Attributes) error {
+	if !c.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("DefaultStorageClass admission plugin not yet ready to handle request"))
+	}
+	if a.GetResource().GroupResource() != api.Resource("persistentvolumeclaims") {
+		return nil
+	}
+	if len(a.GetSubresource()) != 0 {
+		return nil
+	}
+	pvc, ok := a.GetObject().(*api.PersistentVolumeClaim)
+	if !ok {
+		return errors.NewBadRequest("Resource was marked with kind PersistentVolumeClaim but was unable to be converted")
+	}
+
+	if _, ok := pvc.Annotations[classAnnotation]; ok {
+		return nil
+	}
+
+	glog.V(4).Infof("no storage class for claim %s (generate: %s)", pvc.Name, pvc.GenerateName)
+
+	def, err := c.getClassForClaim(pvc)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	if def == nil {
+		return nil
+	}
+
+	glog.V(4).Infof("defaulting storage class for claim %s (generate: %s) to %s", pvc.Name, pvc.GenerateName, def.Name)
+	if pvc.Annotations == nil {
+		pvc.Annotations = map[string]string{}
+	}
+	pvc.Annotations[classAnnotation] = def.Name
+	return nil
+}
+
+// getClassForClaim resolves the StorageClass a claim without an explicit
+// class should get: the namespace's override if it names an existing class,
+// falling back to the cluster default (subject to c.policy) otherwise.
+func (c *claimDefaulterPlugin) getClassForClaim(pvc *api.PersistentVolumeClaim) (*extensions.StorageClass, error) {
+	if override, ok := c.getNamespaceOverride(pvc.Namespace); ok {
+		if class, ok := getClassByName(c.store, override); ok {
+			return class, nil
+		}
+		msg := fmt.Sprintf("namespace %s requests default StorageClass override %q, but no such StorageClass exists; falling back to the cluster default", pvc.Namespace, override)
+		glog.Warning(msg)
+		c.eventf(pvc, clientv1.EventTypeWarning, "DefaultStorageClassOverrideMissing", msg)
+	}
+	return c.getDefaultClass(pvc)
+}
+
+// getNamespaceOverride returns the StorageClass name named by the claim's
+// namespace's namespaceDefaultClassAnnotation, if any.
+func (c *claimDefaulterPlugin) getNamespaceOverride(namespace string) (string, bool) {
+	obj, exists, err := c.namespaceStore.GetByKey(namespace)
+	if err != nil || !exists {
+		return "", false
+	}
+	ns, ok := obj.(*api.Namespace)
+	if !ok {
+		return "", false
+	}
+	override, ok := ns.Annotations[namespaceDefaultClassAnnotation]
+	return override, ok && override != ""
+}
+
+// getClassByName looks up a single StorageClass by name. StorageClasses are
+// cluster-scoped, so their cache key is just the name.
+func getClassByName(store cache.Store, name string) (*extensions.StorageClass, bool) {
+	obj, exists, err := store.GetByKey(name)
+	if err != nil || !exists {
+		return nil, false
+	}
+	class, ok := obj.(*extensions.StorageClass)
+	return class, ok
+}
+
+// getDefaultClass collects every StorageClass flagged as the cluster default
+// and resolves the set down to at most one, according to c.policy. With the
+// default Reject policy, finding more than one is an error naming the
+// conflicting classes, preserving the plugin's original behavior.
+func (c *claimDefaulterPlugin) getDefaultClass(pvc *api.PersistentVolumeClaim) (*extensions.StorageClass, error) {
+	defaultClasses := []*extensions.StorageClass{}
+	for _, obj := range c.store.List() {
+		class, ok := obj.(*extensions.StorageClass)
+		if !ok {
+			continue
+		}
+		if class.Annotations[isDefaultAnnotation] == "true" {
+			defaultClasses = append(defaultClasses, class)
+		}
+	}
+	if len(defaultClasses) == 0 {
+		return nil, nil
+	}
+	if len(defaultClasses) == 1 {
+		return defaultClasses[0], nil
+	}
+
+	// Oldest-first, name as the tiebreak, so PickOldest/PickNewest are just
+	// the head and tail of the sorted slice.
+	sort.Slice(defaultClasses, func(i, j int) bool {
+		if !defaultClasses[i].CreationTimestamp.Equal(defaultClasses[j].CreationTimestamp) {
+			return defaultClasses[i].CreationTimestamp.Before(defaultClasses[j].CreationTimestamp)
+		}
+		return defaultClasses[i].Name < defaultClasses[j].Name
+	})
+
+	names := make([]string, 0, len(defaultClasses))
+	for _, class := range defaultClasses {
+		names = append(names, class.Name)
+	}
+
+	switch c.policy {
+	case PickNewest:
+		winner := defaultClasses[len(defaultClasses)-1]
+		msg := fmt.Sprintf("%d default StorageClasses were found (%v), picking newest: %s", len(defaultClasses), names, winner.Name)
+		glog.Warning(msg)
+		c.eventf(pvc, clientv1.EventTypeWarning, "MultipleDefaultStorageClasses", msg)
+		return winner, nil
+	case PickOldest:
+		winner := defaultClasses[0]
+		msg := fmt.Sprintf("%d default StorageClasses were found (%v), picking oldest: %s", len(defaultClasses), names, winner.Name)
+		glog.Warning(msg)
+		c.eventf(pvc, clientv1.EventTypeWarning, "MultipleDefaultStorageClasses", msg)
+		return winner, nil
+	default:
+		return nil, fmt.Errorf("%d default StorageClasses were found (%v)", len(defaultClasses), names)
+	}
+}
@@ -18,6 +18,7 @@ package admission
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -29,12 +30,16 @@ import (
 )
 
 func TestAdmission(t *testing.T) {
+	olderTimestamp := unversioned.NewTime(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))
+	newerTimestamp := unversioned.NewTime(time.Date(2016, time.January, 2, 0, 0, 0, 0, time.UTC))
+
 	defaultClass1 := &extensions.StorageClass{
 		TypeMeta: unversioned.TypeMeta{
 			Kind: "StorageClass",
 		},
 		ObjectMeta: api.ObjectMeta{
-			Name: "default1",
+			Name:              "default1",
+			CreationTimestamp: olderTimestamp,
 			Annotations: map[string]string{
 				isDefaultAnnotation: "true",
 			},
@@ -46,13 +51,29 @@ func TestAdmission(t *testing.T) {
 			Kind: "StorageClass",
 		},
 		ObjectMeta: api.ObjectMeta{
-			Name: "default2",
+			Name:              "default2",
+			CreationTimestamp: newerTimestamp,
 			Annotations: map[string]string{
 				isDefaultAnnotation: "true",
 			},
 		},
 		Provisioner: "default2",
 	}
+	// Same CreationTimestamp as defaultClass1; the name ("default1a" > "default1")
+	// decides which of the two is treated as "newest".
+	defaultClass1Tied := &extensions.StorageClass{
+		TypeMeta: unversioned.TypeMeta{
+			Kind: "StorageClass",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name:              "default1a",
+			CreationTimestamp: olderTimestamp,
+			Annotations: map[string]string{
+				isDefaultAnnotation: "true",
+			},
+		},
+		Provisioner: "default1a",
+	}
 	// Class that has explicit default = false
 	classWithFalseDefault := &extensions.StorageClass{
 		TypeMeta: unversioned.TypeMeta{
@@ -124,61 +145,166 @@ func TestAdmission(t *testing.T) {
 		},
 	}
 
+	nsWithValidOverride := &api.Namespace{
+		ObjectMeta: api.ObjectMeta{
+			Name: "ns",
+			Annotations: map[string]string{
+				namespaceDefaultClassAnnotation: "default2",
+			},
+		},
+	}
+	nsWithOverrideToMissingClass := &api.Namespace{
+		ObjectMeta: api.ObjectMeta{
+			Name: "ns",
+			Annotations: map[string]string{
+				namespaceDefaultClassAnnotation: "does-not-exist",
+			},
+		},
+	}
+
 	tests := []struct {
 		name              string
 		classes           []*extensions.StorageClass
 		claim             *api.PersistentVolumeClaim
+		policy            MultipleDefaultsPolicy
 		expectError       bool
 		expectedClassName string
+		namespace         *api.Namespace
 	}{
 		{
 			"no default, no modification of PVCs",
 			[]*extensions.StorageClass{classWithFalseDefault, classWithNoDefault, classWithEmptyDefault},
 			claimWithNoClass,
+			"",
 			false,
 			"",
+			nil,
 		},
 		{
 			"one default, modify PVC with class=nil",
 			[]*extensions.StorageClass{defaultClass1, classWithFalseDefault, classWithNoDefault, classWithEmptyDefault},
 			claimWithNoClass,
+			"",
 			false,
 			"default1",
+			nil,
 		},
 		{
 			"one default, no modification of PVC with class=''",
 			[]*extensions.StorageClass{defaultClass1, classWithFalseDefault, classWithNoDefault, classWithEmptyDefault},
 			claimWithEmptyClass,
+			"",
 			false,
 			"",
+			nil,
 		},
 		{
 			"one default, no modification of PVC with class='foo'",
 			[]*extensions.StorageClass{defaultClass1, classWithFalseDefault, classWithNoDefault, classWithEmptyDefault},
 			claimWithClass,
+			"",
 			false,
 			"foo",
+			nil,
 		},
 		{
-			"two defaults, error with PVC with class=nil",
+			"two defaults, default policy (Reject), error with PVC with class=nil",
 			[]*extensions.StorageClass{defaultClass1, defaultClass2, classWithFalseDefault, classWithNoDefault, classWithEmptyDefault},
 			claimWithNoClass,
+			"",
 			true,
 			"",
+			nil,
 		},
 		{
 			"two defaults, no modification of PVC with class=''",
 			[]*extensions.StorageClass{defaultClass1, defaultClass2, classWithFalseDefault, classWithNoDefault, classWithEmptyDefault},
 			claimWithEmptyClass,
+			"",
 			false,
 			"",
+			nil,
 		},
 		{
 			"two defaults, no modification of PVC with class='foo'",
 			[]*extensions.StorageClass{defaultClass1, defaultClass2, classWithFalseDefault, classWithNoDefault, classWithEmptyDefault},
 			claimWithClass,
+			"",
 			false,
 			"foo",
+			nil,
+		},
+		{
+			"two defaults, explicit Reject policy, error with PVC with class=nil",
+			[]*extensions.StorageClass{defaultClass1, defaultClass2, classWithFalseDefault},
+			claimWithNoClass,
+			Reject,
+			true,
+			"",
+			nil,
+		},
+		{
+			"two defaults, PickNewest policy, modify PVC with class=nil",
+			[]*extensions.StorageClass{defaultClass1, defaultClass2, classWithFalseDefault},
+			claimWithNoClass,
+			PickNewest,
+			false,
+			"default2",
+			nil,
+		},
+		{
+			"two defaults, PickOldest policy, modify PVC with class=nil",
+			[]*extensions.StorageClass{defaultClass1, defaultClass2, classWithFalseDefault},
+			claimWithNoClass,
+			PickOldest,
+			false,
+			"default1",
+			nil,
+		},
+		{
+			"two defaults with tied CreationTimestamp, PickNewest policy breaks tie on name",
+			[]*extensions.StorageClass{defaultClass1, defaultClass1Tied, classWithFalseDefault},
+			claimWithNoClass,
+			PickNewest,
+			false,
+			"default1a",
+			nil,
+		},
+		{
+			"namespace override present and valid, wins over cluster default",
+			[]*extensions.StorageClass{defaultClass1, defaultClass2},
+			claimWithNoClass,
+			"",
+			false,
+			"default2",
+			nsWithValidOverride,
+		},
+		{
+			"namespace override names a nonexistent class, falls back to cluster default",
+			[]*extensions.StorageClass{defaultClass1},
+			claimWithNoClass,
+			"",
+			false,
+			"default1",
+			nsWithOverrideToMissingClass,
+		},
+		{
+			"namespace override coexists with two conflicting cluster defaults, override wins without needing the policy tiebreak",
+			[]*extensions.StorageClass{defaultClass1, defaultClass2, classWithFalseDefault},
+			claimWithNoClass,
+			PickOldest,
+			false,
+			"default2",
+			nsWithValidOverride,
+		},
+		{
+			"no namespace override, existing multi-default behavior preserved",
+			[]*extensions.StorageClass{defaultClass1, defaultClass2},
+			claimWithNoClass,
+			"",
+			true,
+			"",
+			nil,
 		},
 	}
 
@@ -192,10 +318,17 @@ func TestAdmission(t *testing.T) {
 		}
 		claim := clone.(*api.PersistentVolumeClaim)
 
-		ctrl := newPlugin(nil)
+		var config *Configuration
+		if test.policy != "" {
+			config = &Configuration{MultipleDefaultsPolicy: test.policy}
+		}
+		ctrl := newPlugin(config)
 		for _, c := range test.classes {
 			ctrl.store.Add(c)
 		}
+		if test.namespace != nil {
+			ctrl.namespaceStore.Add(test.namespace)
+		}
 		attrs := admission.NewAttributesRecord(
 			claim, // new object
 			nil,   // old object